@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"taskservice/internal/config"
+	"taskservice/internal/database"
+	"taskservice/internal/worker"
+	"taskservice/pkg/logger"
+	"taskservice/pkg/observability"
+
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found, using system environment variables")
+	}
+
+	logger := logger.New()
+
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	defer stopWatch()
+
+	atomicCfg, err := config.Watch(watchCtx, logger)
+	if err != nil {
+		logger.Error("Failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+	cfg := atomicCfg.Get()
+
+	if err := observability.Init(cfg.Sentry); err != nil {
+		logger.Error("Failed to initialize observability, continuing without it", "error", err)
+	}
+	defer observability.Flush(2 * time.Second)
+
+	conn, err := database.NewConnection(cfg.Database)
+	if err != nil {
+		logger.Error("Failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	db := database.NewPool(conn)
+	defer func() { db.Current().Close() }()
+
+	broker, err := worker.NewBroker(cfg.Worker)
+	if err != nil {
+		logger.Error("Failed to connect to broker", "error", err)
+		os.Exit(1)
+	}
+	defer broker.Close()
+
+	runner := worker.NewRunner(db, broker, logger, cfg.Worker, worker.NoopExecutor{})
+
+	atomicCfg.Subscribe(func(next *config.Config) {
+		if err := logger.SetLevel(next.LogLevel); err != nil {
+			logger.Error("Failed to apply reloaded log level", "error", err)
+		}
+	})
+	lastDatabaseCfg := cfg.Database
+	atomicCfg.Subscribe(func(next *config.Config) {
+		if next.Database == lastDatabaseCfg {
+			return
+		}
+		lastDatabaseCfg = next.Database
+		if old, err := database.Reconfigure(db, next.Database); err != nil {
+			logger.Error("Failed to apply reloaded database configuration, keeping existing pool", "error", err)
+		} else if old != nil {
+			time.AfterFunc(30*time.Second, func() { old.Close() })
+		}
+	})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	logger.Info("Starting task worker", "broker", cfg.Worker.Broker, "pool_size", cfg.Worker.PoolSize)
+	if err := runner.Start(ctx); err != nil && ctx.Err() == nil {
+		logger.Error("Worker stopped with error", "error", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"taskservice/internal/config"
+	"taskservice/internal/database"
+	"taskservice/internal/models"
+	"taskservice/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+const stateCookieName = "oauth_state"
+const stateCookieTTL = 10 * time.Minute
+
+// Handlers exposes the OAuth2/OIDC login and callback endpoints for a
+// single configured provider.
+type Handlers struct {
+	db       *database.Pool
+	cfg      *config.AtomicConfig
+	logger   *logger.Logger
+	provider *Provider
+}
+
+// NewHandlers builds the login/callback Handlers. provider may be nil if
+// OIDC discovery failed at startup, in which case both endpoints respond
+// with 503 so the rest of the API keeps working. cfg is read on every
+// issued token, so a JWT secret rotation takes effect without a restart.
+func NewHandlers(db *database.Pool, cfg *config.AtomicConfig, log *logger.Logger, provider *Provider) *Handlers {
+	return &Handlers{db: db, cfg: cfg, logger: log, provider: provider}
+}
+
+// Login godoc
+// @Summary Redirect to the provider's OAuth2/OIDC consent screen
+// @Router /api/v1/auth/{provider}/login [get]
+func (h *Handlers) Login(c *gin.Context) {
+	if !h.providerMatches(c) {
+		return
+	}
+
+	state, err := randomState()
+	if err != nil {
+		h.logger.ErrorContext(c.Request.Context(), "Failed to generate oauth state", "error", err)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Error: "internal_error"})
+		return
+	}
+
+	c.SetCookie(stateCookieName, state, int(stateCookieTTL.Seconds()), "/", "", false, true)
+	c.Redirect(http.StatusFound, h.provider.OAuth2.AuthCodeURL(state))
+}
+
+// Callback godoc
+// @Summary Exchange an OAuth2/OIDC authorization code for the module's JWT
+// @Router /api/v1/auth/{provider}/callback [get]
+func (h *Handlers) Callback(c *gin.Context) {
+	if !h.providerMatches(c) {
+		return
+	}
+
+	expectedState, err := c.Cookie(stateCookieName)
+	if err != nil || expectedState == "" || c.Query("state") != expectedState {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Error: "invalid_state", Message: "missing or mismatched oauth state"})
+		return
+	}
+	c.SetCookie(stateCookieName, "", -1, "/", "", false, true)
+
+	ctx := c.Request.Context()
+	oauth2Token, err := h.provider.OAuth2.Exchange(ctx, c.Query("code"))
+	if err != nil {
+		h.logger.ErrorContext(ctx, "Failed to exchange oauth code", "error", err)
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{Error: "exchange_failed"})
+		return
+	}
+
+	rawIDToken, ok := oauth2Token.Extra("id_token").(string)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{Error: "missing_id_token"})
+		return
+	}
+
+	idToken, err := h.provider.Verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "Failed to verify id token", "error", err)
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{Error: "invalid_id_token"})
+		return
+	}
+
+	var idClaims struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err := idToken.Claims(&idClaims); err != nil || idClaims.Email == "" || !idClaims.EmailVerified {
+		c.JSON(http.StatusUnauthorized, models.ErrorResponse{Error: "email_not_verified"})
+		return
+	}
+
+	user, err := h.upsertUser(ctx, idClaims.Email, idClaims.Name, idToken.Subject)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "Failed to upsert user", "email", idClaims.Email, "error", err)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Error: "internal_error"})
+		return
+	}
+
+	token, err := IssueToken(h.cfg.Get().JWT, user.ID.String(), user.Email)
+	if err != nil {
+		h.logger.ErrorContext(ctx, "Failed to issue jwt", "error", err)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Error: "internal_error"})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.LoginResponse{Token: token, User: user})
+}
+
+// providerMatches reports whether the requested :provider path param
+// matches the configured provider, writing an error response otherwise.
+func (h *Handlers) providerMatches(c *gin.Context) bool {
+	if h.provider == nil {
+		c.JSON(http.StatusServiceUnavailable, models.ErrorResponse{Error: "provider_unavailable", Message: "oauth2/oidc login is currently unavailable"})
+		return false
+	}
+	if c.Param("provider") != h.provider.Name {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{Error: "unknown_provider"})
+		return false
+	}
+	return true
+}
+
+func (h *Handlers) upsertUser(ctx context.Context, email, name, sub string) (models.User, error) {
+	var u models.User
+	err := h.db.Current().QueryRowContext(ctx, `
+		INSERT INTO users (email, name, provider, provider_sub)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (email) DO UPDATE SET name = EXCLUDED.name, provider_sub = EXCLUDED.provider_sub, updated_at = now()
+		RETURNING id, email, name, provider, provider_sub, created_at, updated_at`,
+		email, name, h.provider.Name, sub,
+	).Scan(&u.ID, &u.Email, &u.Name, &u.Provider, &u.ProviderSub, &u.CreatedAt, &u.UpdatedAt)
+	return u, err
+}
+
+// findUserBySub looks up the internal user record upsert-ed for an OIDC
+// subject claim. It's how the ID-token auth path (middleware.go) resolves
+// the same internal user identity the JWT path already carries in
+// claims.Subject, instead of tagging the request with the provider's raw
+// sub claim.
+func findUserBySub(ctx context.Context, db *database.Pool, sub string) (models.User, error) {
+	var u models.User
+	err := db.Current().QueryRowContext(ctx, `
+		SELECT id, email, name, provider, provider_sub, created_at, updated_at
+		FROM users WHERE provider_sub = $1`,
+		sub,
+	).Scan(&u.ID, &u.Email, &u.Name, &u.Provider, &u.ProviderSub, &u.CreatedAt, &u.UpdatedAt)
+	return u, err
+}
+
+func randomState() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,64 @@
+// Package auth implements OAuth2/OIDC login alongside the module's
+// locally-issued JWTs, and the middleware that accepts either.
+package auth
+
+import (
+	"fmt"
+	"time"
+
+	"taskservice/internal/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Claims are the custom claims embedded in locally-issued JWTs.
+type Claims struct {
+	Email string `json:"email"`
+	jwt.RegisteredClaims
+}
+
+// IssueToken signs a JWT for the given user, valid for cfg.Expiry hours.
+func IssueToken(cfg config.JWTConfig, userID, email string) (string, error) {
+	claims := Claims{
+		Email: email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID,
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(cfg.Expiry) * time.Hour)),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString([]byte(cfg.Secret))
+	if err != nil {
+		return "", fmt.Errorf("auth: signing token: %w", err)
+	}
+	return signed, nil
+}
+
+// ParseToken verifies a locally-issued JWT and returns its claims. It
+// accepts tokens signed with cfg.Secret as well as any of cfg.PreviousSecrets,
+// so tokens issued just before a secret rotation stay valid until they
+// naturally expire.
+func ParseToken(cfg config.JWTConfig, tokenString string) (*Claims, error) {
+	var lastErr error
+	for _, secret := range append([]string{cfg.Secret}, cfg.PreviousSecrets...) {
+		claims := &Claims{}
+		token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+			if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, fmt.Errorf("auth: unexpected signing method %v", t.Header["alg"])
+			}
+			return []byte(secret), nil
+		})
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if !token.Valid {
+			lastErr = fmt.Errorf("auth: invalid token")
+			continue
+		}
+		return claims, nil
+	}
+	return nil, fmt.Errorf("auth: parsing token: %w", lastErr)
+}
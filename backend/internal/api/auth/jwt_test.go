@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"testing"
+
+	"taskservice/internal/config"
+)
+
+func TestIssueAndParseToken(t *testing.T) {
+	cfg := config.JWTConfig{Secret: "current-secret-value", Expiry: 1}
+
+	token, err := IssueToken(cfg, "user-1", "user@example.com")
+	if err != nil {
+		t.Fatalf("IssueToken returned error: %v", err)
+	}
+
+	claims, err := ParseToken(cfg, token)
+	if err != nil {
+		t.Fatalf("ParseToken returned error: %v", err)
+	}
+	if claims.Subject != "user-1" {
+		t.Errorf("claims.Subject = %q, want %q", claims.Subject, "user-1")
+	}
+	if claims.Email != "user@example.com" {
+		t.Errorf("claims.Email = %q, want %q", claims.Email, "user@example.com")
+	}
+}
+
+func TestParseTokenFallsBackToPreviousSecrets(t *testing.T) {
+	oldCfg := config.JWTConfig{Secret: "old-secret-value", Expiry: 1}
+	token, err := IssueToken(oldCfg, "user-1", "user@example.com")
+	if err != nil {
+		t.Fatalf("IssueToken returned error: %v", err)
+	}
+
+	rotatedCfg := config.JWTConfig{
+		Secret:          "new-secret-value",
+		Expiry:          1,
+		PreviousSecrets: []string{"old-secret-value"},
+	}
+	if _, err := ParseToken(rotatedCfg, token); err != nil {
+		t.Fatalf("ParseToken with rotated secret returned error: %v, want it to fall back to PreviousSecrets", err)
+	}
+}
+
+func TestParseTokenRejectsUnknownSecret(t *testing.T) {
+	issuedCfg := config.JWTConfig{Secret: "issued-with-this-secret", Expiry: 1}
+	token, err := IssueToken(issuedCfg, "user-1", "user@example.com")
+	if err != nil {
+		t.Fatalf("IssueToken returned error: %v", err)
+	}
+
+	rotatedCfg := config.JWTConfig{
+		Secret:          "new-secret-value",
+		Expiry:          1,
+		PreviousSecrets: []string{"some-other-retired-secret"},
+	}
+	if _, err := ParseToken(rotatedCfg, token); err == nil {
+		t.Fatal("ParseToken returned nil error for a token signed with a secret outside Secret/PreviousSecrets")
+	}
+}
@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+
+	"taskservice/internal/config"
+	"taskservice/internal/database"
+	"taskservice/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+const bearerPrefix = "Bearer "
+
+// RequireAuth builds a Gin middleware that accepts either a locally-issued
+// JWT or a valid upstream OIDC ID token. On success it sets "user_id" and
+// "user_email" in the request context for downstream handlers. Both paths
+// resolve to the same internal users.id: the ID-token path looks its
+// subject up via provider_sub (the same value upsertUser stores at login)
+// rather than tagging the request with the provider's raw sub claim, so a
+// task's CreatedBy/Sentry user_id tag doesn't depend on which of the two
+// accepted credentials a given request happened to use. provider may be
+// nil, in which case only the locally-issued JWT is accepted. cfg is read
+// on every request (rather than captured once), so a JWT secret rotation
+// picked up by cfg takes effect without restarting the server.
+func RequireAuth(cfg *config.AtomicConfig, db *database.Pool, provider *Provider) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if !strings.HasPrefix(header, bearerPrefix) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{Error: "missing_token"})
+			return
+		}
+		tokenString := strings.TrimPrefix(header, bearerPrefix)
+
+		if claims, err := ParseToken(cfg.Get().JWT, tokenString); err == nil {
+			c.Set("user_id", claims.Subject)
+			c.Set("user_email", claims.Email)
+			c.Next()
+			return
+		}
+
+		if provider != nil {
+			if idToken, err := provider.Verifier.Verify(c.Request.Context(), tokenString); err == nil {
+				user, err := findUserBySub(c.Request.Context(), db, idToken.Subject)
+				if err != nil {
+					c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{Error: "unknown_subject", Message: "oidc subject has not logged in via the callback flow"})
+					return
+				}
+				c.Set("user_id", user.ID.String())
+				c.Set("user_email", user.Email)
+				c.Next()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusUnauthorized, models.ErrorResponse{Error: "invalid_token"})
+	}
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"taskservice/internal/config"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+// googleIssuerURL is used when OAuth2Config.Provider is "google" and no
+// explicit issuer URL is configured.
+const googleIssuerURL = "https://accounts.google.com"
+
+// Provider wraps the OIDC discovery and OAuth2 plumbing needed to drive one
+// login/callback flow. The ID token verifier caches JWKS keys internally
+// and refreshes them as the provider rotates signing keys.
+type Provider struct {
+	Name     string
+	OAuth2   oauth2.Config
+	Verifier *oidc.IDTokenVerifier
+}
+
+// NewProvider discovers the configured OIDC provider and builds the OAuth2
+// config used by the login and callback handlers.
+func NewProvider(ctx context.Context, cfg config.OAuth2Config) (*Provider, error) {
+	issuerURL := cfg.IssuerURL
+	if issuerURL == "" && cfg.Provider == "google" {
+		issuerURL = googleIssuerURL
+	}
+	if issuerURL == "" {
+		return nil, fmt.Errorf("auth: issuer url required for provider %q", cfg.Provider)
+	}
+
+	oidcProvider, err := oidc.NewProvider(ctx, issuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("auth: discovering oidc provider %q: %w", cfg.Provider, err)
+	}
+
+	scopes := append([]string{oidc.ScopeOpenID}, cfg.Scopes...)
+
+	return &Provider{
+		Name: cfg.Provider,
+		OAuth2: oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Endpoint:     oidcProvider.Endpoint(),
+			Scopes:       scopes,
+		},
+		Verifier: oidcProvider.VerifierContext(ctx, &oidc.Config{ClientID: cfg.ClientID}),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestEncodeDecodeCursorRoundTrip(t *testing.T) {
+	createdAt := time.Date(2026, 7, 29, 12, 0, 0, 123456789, time.UTC)
+	id := uuid.New()
+
+	cursor := encodeCursor(createdAt, id)
+	gotCreatedAt, gotID, err := decodeCursor(cursor)
+	if err != nil {
+		t.Fatalf("decodeCursor returned error: %v", err)
+	}
+	if !gotCreatedAt.Equal(createdAt) {
+		t.Errorf("decoded createdAt = %v, want %v", gotCreatedAt, createdAt)
+	}
+	if gotID != id {
+		t.Errorf("decoded id = %v, want %v", gotID, id)
+	}
+}
+
+func TestDecodeCursorRejectsInvalidInput(t *testing.T) {
+	enc := func(raw string) string { return base64.RawURLEncoding.EncodeToString([]byte(raw)) }
+
+	cases := map[string]string{
+		"not base64":        "not a valid base64 cursor!!",
+		"missing separator": enc("no-separator-here"),
+		"bad timestamp":     enc("not-a-time|" + uuid.New().String()),
+		"bad uuid":          enc(time.Now().Format(time.RFC3339Nano) + "|not-a-uuid"),
+	}
+	for name, cursor := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := decodeCursor(cursor); err == nil {
+				t.Errorf("decodeCursor(%q) returned nil error, want one", cursor)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Package api wires together the HTTP routes for the task service.
+package api
+
+import (
+	"context"
+
+	"taskservice/internal/api/auth"
+	"taskservice/internal/config"
+	"taskservice/internal/database"
+	"taskservice/internal/worker"
+	"taskservice/pkg/embeddings"
+	"taskservice/pkg/logger"
+	"taskservice/pkg/observability"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+)
+
+// Server holds the dependencies shared by all HTTP handlers.
+type Server struct {
+	db           *database.Pool
+	logger       *logger.Logger
+	cfg          *config.AtomicConfig
+	embeddings   embeddings.Provider
+	broker       worker.Broker
+	authProvider *auth.Provider
+	authHandlers *auth.Handlers
+	router       *gin.Engine
+}
+
+// NewServer constructs a Server and registers all routes. cfg is consulted
+// on every request by the JWT middleware and token issuance, so a config
+// reload (see config.Watch) takes effect without restarting the process;
+// the embedding, broker and OAuth2/OIDC providers below are still built
+// once at startup from the config in effect at the time.
+func NewServer(db *database.Pool, log *logger.Logger, cfg *config.AtomicConfig) *Server {
+	current := cfg.Get()
+
+	embeddingProvider, err := embeddings.NewProvider(current.Embedding)
+	if err != nil {
+		log.Error("Failed to initialize embedding provider, semantic search will fall back to text search", "error", err)
+	}
+
+	broker, err := worker.NewBroker(current.Worker)
+	if err != nil {
+		log.Error("Failed to connect to task run broker, task execution endpoints will be unavailable", "error", err)
+	}
+
+	authProvider, err := auth.NewProvider(context.Background(), current.OAuth2)
+	if err != nil {
+		log.Error("Failed to initialize OAuth2/OIDC provider, upstream login will be unavailable", "error", err)
+	}
+
+	s := &Server{
+		db:           db,
+		logger:       log,
+		cfg:          cfg,
+		embeddings:   embeddingProvider,
+		broker:       broker,
+		authProvider: authProvider,
+		authHandlers: auth.NewHandlers(db, cfg, log, authProvider),
+	}
+
+	s.router = gin.New()
+	s.router.Use(gin.Recovery())
+	s.router.Use(observability.GinMiddleware())
+	s.router.Use(cors.Default())
+	s.registerRoutes()
+
+	return s
+}
+
+func (s *Server) registerRoutes() {
+	s.router.GET("/health", s.handleHealth)
+
+	v1 := s.router.Group("/api/v1")
+	{
+		authGroup := v1.Group("/auth/:provider")
+		{
+			authGroup.GET("/login", s.authHandlers.Login)
+			authGroup.GET("/callback", s.authHandlers.Callback)
+		}
+
+		tasks := v1.Group("/tasks")
+		tasks.Use(auth.RequireAuth(s.cfg, s.db, s.authProvider))
+		{
+			tasks.GET("", s.listTasks)
+			tasks.GET("/search", s.searchTasks)
+			tasks.POST("", s.createTask)
+			tasks.GET("/:id", s.getTask)
+			tasks.PUT("/:id", s.updateTask)
+			tasks.DELETE("/:id", s.deleteTask)
+			tasks.POST("/:id/run", s.runTask)
+			tasks.GET("/:id/runs/stream", s.streamTaskRuns)
+		}
+	}
+}
+
+// Run starts the HTTP server on the given address.
+func (s *Server) Run(addr string) error {
+	return s.router.Run(addr)
+}
+
+func (s *Server) handleHealth(c *gin.Context) {
+	c.JSON(200, gin.H{"status": "ok"})
+}
@@ -0,0 +1,333 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"taskservice/internal/models"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var validate = validator.New()
+
+const taskColumns = `id, title, description, duration, status, priority, tags, metadata,
+	estimated_start, estimated_end, actual_start, actual_end, created_at, updated_at, created_by`
+
+func scanTask(row interface{ Scan(...interface{}) error }) (models.Task, error) {
+	var t models.Task
+	err := row.Scan(
+		&t.ID, &t.Title, &t.Description, &t.Duration, &t.Status, &t.Priority, &t.Tags, &t.Metadata,
+		&t.EstimatedStart, &t.EstimatedEnd, &t.ActualStart, &t.ActualEnd, &t.CreatedAt, &t.UpdatedAt, &t.CreatedBy,
+	)
+	return t, err
+}
+
+// createTask godoc
+// @Summary Create a task
+// @Router /api/v1/tasks [post]
+func (s *Server) createTask(c *gin.Context) {
+	var req models.CreateTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Error: "invalid_request", Message: err.Error()})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Error: "validation_failed", Message: err.Error()})
+		return
+	}
+
+	priority := models.TaskPriorityMedium
+	if req.Priority != nil {
+		priority = *req.Priority
+	}
+
+	row := s.db.Current().QueryRowContext(c.Request.Context(), `
+		INSERT INTO tasks (title, description, duration, status, priority, tags, metadata, estimated_start, estimated_end, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING `+taskColumns,
+		req.Title, req.Description, req.Duration, models.TaskStatusPending, priority,
+		pq.StringArray(req.Tags), req.Metadata, req.EstimatedStart, req.EstimatedEnd, c.GetString("user_id"),
+	)
+
+	task, err := scanTask(row)
+	if err != nil {
+		s.logger.ErrorContext(c.Request.Context(), "Failed to create task", "error", err)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Error: "internal_error", Message: "failed to create task"})
+		return
+	}
+
+	s.updateTaskEmbedding(c.Request.Context(), task)
+
+	c.JSON(http.StatusCreated, task)
+}
+
+// getTask godoc
+// @Summary Get a task by ID
+// @Router /api/v1/tasks/{id} [get]
+func (s *Server) getTask(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Error: "invalid_id"})
+		return
+	}
+
+	row := s.db.Current().QueryRowContext(c.Request.Context(), `SELECT `+taskColumns+` FROM tasks WHERE id = $1`, id)
+	task, err := scanTask(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{Error: "not_found"})
+		return
+	}
+	if err != nil {
+		s.logger.ErrorContext(c.Request.Context(), "Failed to get task", "error", err)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Error: "internal_error"})
+		return
+	}
+
+	c.JSON(http.StatusOK, task)
+}
+
+// updateTask godoc
+// @Summary Update a task
+// @Router /api/v1/tasks/{id} [put]
+func (s *Server) updateTask(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Error: "invalid_id"})
+		return
+	}
+
+	var req models.UpdateTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Error: "invalid_request", Message: err.Error()})
+		return
+	}
+	if err := validate.Struct(req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Error: "validation_failed", Message: err.Error()})
+		return
+	}
+
+	row := s.db.Current().QueryRowContext(c.Request.Context(), `
+		UPDATE tasks SET
+			title = COALESCE($2, title),
+			description = COALESCE($3, description),
+			duration = COALESCE($4, duration),
+			status = COALESCE($5, status),
+			priority = COALESCE($6, priority),
+			tags = COALESCE($7, tags),
+			metadata = COALESCE($8, metadata),
+			estimated_start = COALESCE($9, estimated_start),
+			estimated_end = COALESCE($10, estimated_end),
+			actual_start = COALESCE($11, actual_start),
+			actual_end = COALESCE($12, actual_end),
+			updated_at = now()
+		WHERE id = $1
+		RETURNING `+taskColumns,
+		id, req.Title, req.Description, req.Duration, req.Status, req.Priority,
+		tagsOrNil(req.Tags), req.Metadata, req.EstimatedStart, req.EstimatedEnd, req.ActualStart, req.ActualEnd,
+	)
+
+	task, err := scanTask(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{Error: "not_found"})
+		return
+	}
+	if err != nil {
+		s.logger.ErrorContext(c.Request.Context(), "Failed to update task", "error", err)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Error: "internal_error"})
+		return
+	}
+
+	s.updateTaskEmbedding(c.Request.Context(), task)
+
+	c.JSON(http.StatusOK, task)
+}
+
+// deleteTask godoc
+// @Summary Delete a task
+// @Router /api/v1/tasks/{id} [delete]
+func (s *Server) deleteTask(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Error: "invalid_id"})
+		return
+	}
+
+	result, err := s.db.Current().ExecContext(c.Request.Context(), `DELETE FROM tasks WHERE id = $1`, id)
+	if err != nil {
+		s.logger.ErrorContext(c.Request.Context(), "Failed to delete task", "error", err)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Error: "internal_error"})
+		return
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{Error: "not_found"})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// listTasks godoc
+// @Summary List tasks
+// @Description Supports offset pagination (page/limit) or keyset pagination
+// @Description (cursor/limit), plus optional status, priority and tags filters.
+// @Description tag_match accepts "any" or "all"; "fuzzy" is not supported -
+// @Description see the note on taskListFilters.
+// @Router /api/v1/tasks [get]
+func (s *Server) listTasks(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+
+	where, args, err := taskListFilters(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Error: "invalid_request", Message: err.Error()})
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	if cursor := c.Query("cursor"); cursor != "" {
+		createdAt, id, err := decodeCursor(cursor)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{Error: "invalid_cursor"})
+			return
+		}
+
+		args = append(args, createdAt, id)
+		where = append(where, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+		args = append(args, limit)
+
+		query := `SELECT ` + taskColumns + ` FROM tasks` + whereClause(where) +
+			fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", len(args))
+
+		tasks, err := s.queryTasks(ctx, query, args)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "Failed to list tasks", "error", err)
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{Error: "internal_error"})
+			return
+		}
+
+		resp := models.TasksResponse{Tasks: tasks, Limit: limit}
+		if len(tasks) == limit {
+			last := tasks[len(tasks)-1]
+			resp.NextCursor = encodeCursor(last.CreatedAt, last.ID)
+		}
+		c.JSON(http.StatusOK, resp)
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if page < 1 {
+		page = 1
+	}
+	offset := (page - 1) * limit
+
+	pagedArgs := append(append([]interface{}{}, args...), limit, offset)
+	query := `SELECT ` + taskColumns + ` FROM tasks` + whereClause(where) +
+		fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d", len(pagedArgs)-1, len(pagedArgs))
+
+	tasks, err := s.queryTasks(ctx, query, pagedArgs)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "Failed to list tasks", "error", err)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Error: "internal_error"})
+		return
+	}
+
+	var total int
+	countQuery := `SELECT count(*) FROM tasks` + whereClause(where)
+	if err := s.db.Current().QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		s.logger.ErrorContext(ctx, "Failed to count tasks", "error", err)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Error: "internal_error"})
+		return
+	}
+
+	resp := models.TasksResponse{Tasks: tasks, Total: total, Page: page, Limit: limit}
+	if len(tasks) > 0 && offset+len(tasks) < total {
+		last := tasks[len(tasks)-1]
+		resp.NextCursor = encodeCursor(last.CreatedAt, last.ID)
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// taskListFilters builds the WHERE clauses and positional args for the
+// status, priority and tags query params shared by both pagination modes.
+//
+// tag_match only supports "any" (tags && $n) and "all" (tags @> $n); any
+// other value, "fuzzy" included, is rejected with an error. Fuzzy matching
+// was removed together with its trigram index (migration 000007): the
+// index covered the joined tag string, while the fuzzy query compared tags
+// one by one via unnest, which that index could not serve.
+func taskListFilters(c *gin.Context) ([]string, []interface{}, error) {
+	var where []string
+	var args []interface{}
+
+	if status := c.Query("status"); status != "" {
+		args = append(args, status)
+		where = append(where, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if priority := c.Query("priority"); priority != "" {
+		args = append(args, priority)
+		where = append(where, fmt.Sprintf("priority = $%d", len(args)))
+	}
+
+	if tagsParam := c.Query("tags"); tagsParam != "" {
+		tags := strings.Split(tagsParam, ",")
+		switch match := c.DefaultQuery("tag_match", "any"); match {
+		case "any":
+			args = append(args, pq.StringArray(tags))
+			where = append(where, fmt.Sprintf("tags && $%d", len(args)))
+		case "all":
+			args = append(args, pq.StringArray(tags))
+			where = append(where, fmt.Sprintf("tags @> $%d", len(args)))
+		default:
+			return nil, nil, fmt.Errorf("tag_match must be any or all")
+		}
+	}
+
+	return where, args, nil
+}
+
+// whereClause joins filter clauses into a SQL WHERE clause, or returns an
+// empty string when there are none.
+func whereClause(clauses []string) string {
+	if len(clauses) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(clauses, " AND ")
+}
+
+// queryTasks runs a SELECT over tasks and scans every row.
+func (s *Server) queryTasks(ctx context.Context, query string, args []interface{}) ([]models.Task, error) {
+	rows, err := s.db.Current().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := make([]models.Task, 0)
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
+
+func tagsOrNil(tags *[]string) interface{} {
+	if tags == nil {
+		return nil
+	}
+	return pq.StringArray(*tags)
+}
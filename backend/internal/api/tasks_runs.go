@@ -0,0 +1,132 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"taskservice/internal/models"
+	"taskservice/internal/worker"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const runsPollInterval = 500 * time.Millisecond
+
+// runTask godoc
+// @Summary Enqueue a task for asynchronous execution
+// @Router /api/v1/tasks/{id}/run [post]
+func (s *Server) runTask(c *gin.Context) {
+	if s.broker == nil {
+		c.JSON(http.StatusServiceUnavailable, models.ErrorResponse{Error: "broker_unavailable", Message: "task execution is currently unavailable"})
+		return
+	}
+
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Error: "invalid_id"})
+		return
+	}
+
+	var exists bool
+	err = s.db.Current().QueryRowContext(c.Request.Context(), `SELECT EXISTS(SELECT 1 FROM tasks WHERE id = $1)`, taskID).Scan(&exists)
+	if err != nil {
+		s.logger.ErrorContext(c.Request.Context(), "Failed to check task existence", "task_id", taskID, "error", err)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Error: "internal_error"})
+		return
+	}
+	if !exists {
+		c.JSON(http.StatusNotFound, models.ErrorResponse{Error: "not_found"})
+		return
+	}
+
+	runID := uuid.New()
+	_, err = s.db.Current().ExecContext(c.Request.Context(), `
+		INSERT INTO task_runs (id, task_id, attempt, status) VALUES ($1, $2, 1, $3)`,
+		runID, taskID, models.TaskStatusPending,
+	)
+	if err != nil {
+		s.logger.ErrorContext(c.Request.Context(), "Failed to create task run", "task_id", taskID, "error", err)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Error: "internal_error"})
+		return
+	}
+
+	job := worker.Job{TaskID: taskID.String(), RunID: runID.String(), Attempt: 1}
+	if err := s.broker.Enqueue(c.Request.Context(), job); err != nil {
+		s.logger.ErrorContext(c.Request.Context(), "Failed to enqueue task run", "task_id", taskID, "error", err)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Error: "internal_error"})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, models.RunTaskResponse{
+		RunID: runID, TaskID: taskID, Status: models.TaskStatusPending, Attempt: 1,
+	})
+}
+
+// streamTaskRuns godoc
+// @Summary Tail the output of a task's most recent run via SSE
+// @Router /api/v1/tasks/{id}/runs/stream [get]
+func (s *Server) streamTaskRuns(c *gin.Context) {
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Error: "invalid_id"})
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+
+	sentLen := 0
+	c.Stream(func(w io.Writer) bool {
+		run, err := s.latestTaskRun(c.Request.Context(), taskID)
+		if errors.Is(err, sql.ErrNoRows) {
+			c.SSEvent("error", "no runs found for task")
+			return false
+		}
+		if err != nil {
+			s.logger.ErrorContext(c.Request.Context(), "Failed to load task run for stream", "task_id", taskID, "error", err)
+			c.SSEvent("error", "internal error")
+			return false
+		}
+
+		if len(run.Logs) > sentLen {
+			c.SSEvent("log", run.Logs[sentLen:])
+			sentLen = len(run.Logs)
+		}
+
+		if isTerminalStatus(run.Status) {
+			c.SSEvent("status", run.Status)
+			return false
+		}
+
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case <-time.After(runsPollInterval):
+			return true
+		}
+	})
+}
+
+func (s *Server) latestTaskRun(ctx context.Context, taskID uuid.UUID) (models.TaskRun, error) {
+	var run models.TaskRun
+	err := s.db.Current().QueryRowContext(ctx, `
+		SELECT id, task_id, attempt, status, logs, error, started_at, ended_at, created_at
+		FROM task_runs WHERE task_id = $1 ORDER BY created_at DESC LIMIT 1`, taskID,
+	).Scan(&run.ID, &run.TaskID, &run.Attempt, &run.Status, &run.Logs, &run.Error, &run.StartedAt, &run.EndedAt, &run.CreatedAt)
+	return run, err
+}
+
+func isTerminalStatus(status models.TaskStatus) bool {
+	switch status {
+	case models.TaskStatusCompleted, models.TaskStatusFailed, models.TaskStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,154 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"taskservice/internal/models"
+	"taskservice/pkg/embeddings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// updateTaskEmbedding (re)computes and stores the embedding for a task from
+// its title, description and tags. Failures are logged and otherwise
+// swallowed so embedding generation never blocks a create/update request.
+func (s *Server) updateTaskEmbedding(ctx context.Context, task models.Task) {
+	if s.embeddings == nil {
+		return
+	}
+
+	text := embeddingSourceText(task)
+	vector, err := s.embeddings.Embed(ctx, text)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "Failed to compute task embedding", "task_id", task.ID, "error", err)
+		return
+	}
+
+	_, err = s.db.Current().ExecContext(ctx, `UPDATE tasks SET embedding = $2 WHERE id = $1`, task.ID, vectorLiteral(vector))
+	if err != nil {
+		s.logger.ErrorContext(ctx, "Failed to store task embedding", "task_id", task.ID, "error", err)
+	}
+}
+
+func embeddingSourceText(task models.Task) string {
+	var b strings.Builder
+	b.WriteString(task.Title)
+	if task.Description != nil {
+		b.WriteString(" ")
+		b.WriteString(*task.Description)
+	}
+	if len(task.Tags) > 0 {
+		b.WriteString(" ")
+		b.WriteString(strings.Join(task.Tags, " "))
+	}
+	return b.String()
+}
+
+func vectorLiteral(vector []float32) string {
+	parts := make([]string, len(vector))
+	for i, v := range vector {
+		parts[i] = strconv.FormatFloat(float64(v), 'f', -1, 32)
+	}
+	return "[" + strings.Join(parts, ",") + "]"
+}
+
+// searchTasks godoc
+// @Summary Semantic search over tasks
+// @Router /api/v1/tasks/search [get]
+func (s *Server) searchTasks(c *gin.Context) {
+	query := strings.TrimSpace(c.Query("q"))
+	if query == "" {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{Error: "invalid_request", Message: "q is required"})
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+
+	ctx := c.Request.Context()
+
+	if s.embeddings != nil {
+		vector, err := s.embeddings.Embed(ctx, query)
+		if err == nil {
+			results, err := s.semanticSearch(ctx, vector, limit, offset)
+			if err == nil {
+				c.JSON(http.StatusOK, results)
+				return
+			}
+			s.logger.ErrorContext(ctx, "Semantic search query failed, falling back to text search", "error", err)
+		} else if errors.Is(err, embeddings.ErrProviderUnreachable) {
+			s.logger.ErrorContext(ctx, "Embedding provider unreachable, falling back to text search", "error", err)
+		} else {
+			s.logger.ErrorContext(ctx, "Failed to embed search query, falling back to text search", "error", err)
+		}
+	}
+
+	results, err := s.textSearch(ctx, query, limit, offset)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "Failed to search tasks", "error", err)
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{Error: "internal_error"})
+		return
+	}
+	c.JSON(http.StatusOK, results)
+}
+
+func (s *Server) semanticSearch(ctx context.Context, vector []float32, limit, offset int) ([]models.SearchTasksResponse, error) {
+	rows, err := s.db.Current().QueryContext(ctx, `
+		SELECT id, title, description, 1 - (embedding <=> $1) AS similarity_score
+		FROM tasks
+		WHERE embedding IS NOT NULL
+		ORDER BY embedding <=> $1
+		LIMIT $2 OFFSET $3`,
+		vectorLiteral(vector), limit, offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying by embedding distance: %w", err)
+	}
+	defer rows.Close()
+
+	return scanSearchResults(rows)
+}
+
+func (s *Server) textSearch(ctx context.Context, query string, limit, offset int) ([]models.SearchTasksResponse, error) {
+	rows, err := s.db.Current().QueryContext(ctx, `
+		SELECT id, title, description, 0 AS similarity_score
+		FROM tasks
+		WHERE title ILIKE '%' || $1 || '%' OR description ILIKE '%' || $1 || '%'
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3`,
+		query, limit, offset,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying by ILIKE: %w", err)
+	}
+	defer rows.Close()
+
+	return scanSearchResults(rows)
+}
+
+func scanSearchResults(rows interface {
+	Next() bool
+	Scan(...interface{}) error
+}) ([]models.SearchTasksResponse, error) {
+	results := make([]models.SearchTasksResponse, 0)
+	for rows.Next() {
+		var r models.SearchTasksResponse
+		if err := rows.Scan(&r.TaskID, &r.Title, &r.Description, &r.Similarity); err != nil {
+			return nil, fmt.Errorf("scanning search result: %w", err)
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
@@ -0,0 +1,70 @@
+package config
+
+import "sync"
+
+// maxRotatedSecrets bounds how many retired JWT secrets ParseToken will
+// still accept after a rotation, so tokens issued just before a reload
+// keep working for a little while without letting the list grow forever.
+const maxRotatedSecrets = 2
+
+// AtomicConfig holds a Config that can be swapped out while the process is
+// running, so subsystems that support hot-reload (see Watch) pick up new
+// values without a restart.
+type AtomicConfig struct {
+	mu          sync.RWMutex
+	cfg         *Config
+	subscribers []func(*Config)
+}
+
+// NewAtomicConfig wraps an already-loaded Config.
+func NewAtomicConfig(cfg *Config) *AtomicConfig {
+	return &AtomicConfig{cfg: cfg}
+}
+
+// Get returns the Config currently in effect. Safe for concurrent use with
+// reload.
+func (a *AtomicConfig) Get() *Config {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.cfg
+}
+
+// Subscribe registers fn to run, with the new Config, every time reload
+// installs one. fn also runs once immediately with the current Config, so
+// callers don't need a separate initial read.
+func (a *AtomicConfig) Subscribe(fn func(*Config)) {
+	a.mu.Lock()
+	a.subscribers = append(a.subscribers, fn)
+	current := a.cfg
+	a.mu.Unlock()
+
+	fn(current)
+}
+
+// reload carries forward JWT secret rotation history, installs next as the
+// current Config, and notifies subscribers. Unexported: only Watch's
+// reload loop calls it, since next must already be validated.
+func (a *AtomicConfig) reload(next *Config) {
+	a.mu.Lock()
+	prev := a.cfg
+	if prev != nil && prev.JWT.Secret != "" && prev.JWT.Secret != next.JWT.Secret {
+		next.JWT.PreviousSecrets = rotateSecrets(prev.JWT.Secret, prev.JWT.PreviousSecrets)
+	} else if prev != nil {
+		next.JWT.PreviousSecrets = prev.JWT.PreviousSecrets
+	}
+	a.cfg = next
+	subscribers := append([]func(*Config){}, a.subscribers...)
+	a.mu.Unlock()
+
+	for _, fn := range subscribers {
+		fn(next)
+	}
+}
+
+func rotateSecrets(retired string, previous []string) []string {
+	rotated := append([]string{retired}, previous...)
+	if len(rotated) > maxRotatedSecrets {
+		rotated = rotated[:maxRotatedSecrets]
+	}
+	return rotated
+}
@@ -1,52 +1,253 @@
 package config
 
 import (
+	"encoding/json"
+	"fmt"
+	"log"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v3"
 )
 
+var validate = validator.New()
+
 // Config holds all configuration for the application
 type Config struct {
-	Port     string
-	Database DatabaseConfig
-	JWT      JWTConfig
+	Port      string `validate:"required"`
+	LogLevel  string `validate:"required,oneof=debug info warn error"`
+	Database  DatabaseConfig
+	JWT       JWTConfig
+	OAuth2    OAuth2Config
+	Embedding EmbeddingConfig
+	Worker    WorkerConfig
+	Sentry    SentryConfig
 }
 
 // DatabaseConfig holds database configuration
 type DatabaseConfig struct {
-	Host     string
-	Port     string
-	User     string
+	Host     string `validate:"required"`
+	Port     string `validate:"required"`
+	User     string `validate:"required"`
 	Password string
-	Name     string
+	Name     string `validate:"required"`
 	SSLMode  string
 }
 
 // JWTConfig holds JWT configuration
 type JWTConfig struct {
-	Secret string
-	Expiry int // in hours
+	Secret string `validate:"required,min=8"`
+	Expiry int    `validate:"gte=1"` // in hours
+
+	// PreviousSecrets holds the most recently retired signing secrets, so
+	// tokens issued just before a rotation keep verifying. It is maintained
+	// automatically by AtomicConfig's reload and is never read from
+	// config sources directly.
+	PreviousSecrets []string `json:"-" yaml:"-" validate:"-"`
+}
+
+// OAuth2Config holds configuration for OAuth2/OIDC login, used alongside
+// the locally-issued JWTs described by JWTConfig.
+type OAuth2Config struct {
+	Provider     string // "google" or "oidc"
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// EmbeddingConfig holds configuration for the semantic search embedding provider
+type EmbeddingConfig struct {
+	Provider    string // "openai" or "ollama"
+	ProviderURL string
+	APIKey      string
+	Model       string
+	// Dimension must match embeddings.StoredDimension, the fixed width of
+	// the tasks.embedding pgvector column; embeddings.NewProvider rejects
+	// any other value.
+	Dimension int
 }
 
-// Load loads configuration from environment variables
+// WorkerConfig holds configuration for the async task execution worker
+type WorkerConfig struct {
+	Broker       string // "nats" or "redis"
+	BrokerURL    string
+	PoolSize     int `validate:"gte=1"`
+	MaxAttempts  int `validate:"gte=1"`
+	RetryBackoff int // base backoff in seconds, doubled per attempt
+}
+
+// SentryConfig holds configuration for Sentry error tracking and the
+// OpenTelemetry tracing bridged through it.
+type SentryConfig struct {
+	DSN                string
+	Environment        string
+	TracesSampleRate   float64 `validate:"gte=0,lte=1"`
+	ProfilesSampleRate float64 `validate:"gte=0,lte=1"`
+}
+
+// Load builds a Config by layering, in increasing order of priority:
+// built-in defaults, an optional file named by CONFIG_PATH (YAML unless it
+// has a .json extension), environment variables, and file-backed secret
+// references such as JWT_SECRET_FILE=/run/secrets/jwt. It returns a
+// one-shot snapshot; use Watch to keep it live and reloadable.
 func Load() *Config {
+	cfg := defaults()
+
+	if path := os.Getenv("CONFIG_PATH"); path != "" {
+		if err := mergeFile(cfg, path); err != nil {
+			log.Printf("config: %v, continuing with defaults/env", err)
+		}
+	}
+
+	applyEnvOverrides(cfg)
+	applySecretFiles(cfg)
+
+	return cfg
+}
+
+// Validate rejects a Config with obviously broken values, so a reload
+// triggered by Watch is discarded rather than applied.
+func Validate(cfg *Config) error {
+	if err := validate.Struct(cfg); err != nil {
+		return fmt.Errorf("config: invalid configuration: %w", err)
+	}
+	return nil
+}
+
+// defaults returns the built-in configuration, before any file, env or
+// secret-file layer is applied.
+func defaults() *Config {
 	return &Config{
-		Port: getEnv("PORT", "8080"),
+		Port:     "8080",
+		LogLevel: "info",
 		Database: DatabaseConfig{
-			Host:     getEnv("DB_HOST", "localhost"),
-			Port:     getEnv("DB_PORT", "5432"),
-			User:     getEnv("DB_USER", "taskuser"),
-			Password: getEnv("DB_PASSWORD", "taskpassword"),
-			Name:     getEnv("DB_NAME", "taskdb"),
-			SSLMode:  getEnv("DB_SSLMODE", "disable"),
+			Host:     "localhost",
+			Port:     "5432",
+			User:     "taskuser",
+			Password: "taskpassword",
+			Name:     "taskdb",
+			SSLMode:  "disable",
 		},
 		JWT: JWTConfig{
-			Secret: getEnv("JWT_SECRET", "your-secret-key"),
-			Expiry: getEnvAsInt("JWT_EXPIRY_HOURS", 24),
+			Secret: "your-secret-key",
+			Expiry: 24,
+		},
+		OAuth2: OAuth2Config{
+			Provider:    "google",
+			RedirectURL: "http://localhost:8080/api/v1/auth/google/callback",
+			Scopes:      []string{"email", "profile"},
+		},
+		Embedding: EmbeddingConfig{
+			Provider:    "openai",
+			ProviderURL: "https://api.openai.com/v1/embeddings",
+			Model:       "text-embedding-3-small",
+			Dimension:   1536,
+		},
+		Worker: WorkerConfig{
+			Broker:       "nats",
+			BrokerURL:    "nats://localhost:4222",
+			PoolSize:     5,
+			MaxAttempts:  3,
+			RetryBackoff: 2,
+		},
+		Sentry: SentryConfig{
+			Environment:        "development",
+			TracesSampleRate:   0.2,
+			ProfilesSampleRate: 0.2,
 		},
 	}
 }
 
+// mergeFile decodes the file at path on top of cfg, so fields it omits keep
+// whatever defaults() already set. JSON is used for a .json extension,
+// YAML otherwise.
+func mergeFile(cfg *Config, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file %s: %w", path, err)
+	}
+
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		err = json.Unmarshal(data, cfg)
+	} else {
+		err = yaml.Unmarshal(data, cfg)
+	}
+	if err != nil {
+		return fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// applyEnvOverrides applies the module's long-standing environment
+// variables on top of whatever defaults() and mergeFile already set.
+func applyEnvOverrides(cfg *Config) {
+	cfg.Port = getEnv("PORT", cfg.Port)
+	cfg.LogLevel = getEnv("LOG_LEVEL", cfg.LogLevel)
+
+	cfg.Database.Host = getEnv("DB_HOST", cfg.Database.Host)
+	cfg.Database.Port = getEnv("DB_PORT", cfg.Database.Port)
+	cfg.Database.User = getEnv("DB_USER", cfg.Database.User)
+	cfg.Database.Password = getEnv("DB_PASSWORD", cfg.Database.Password)
+	cfg.Database.Name = getEnv("DB_NAME", cfg.Database.Name)
+	cfg.Database.SSLMode = getEnv("DB_SSLMODE", cfg.Database.SSLMode)
+
+	cfg.JWT.Secret = getEnv("JWT_SECRET", cfg.JWT.Secret)
+	cfg.JWT.Expiry = getEnvAsInt("JWT_EXPIRY_HOURS", cfg.JWT.Expiry)
+
+	cfg.OAuth2.Provider = getEnv("OAUTH2_PROVIDER", cfg.OAuth2.Provider)
+	cfg.OAuth2.IssuerURL = getEnv("OAUTH2_ISSUER_URL", cfg.OAuth2.IssuerURL)
+	cfg.OAuth2.ClientID = getEnv("OAUTH2_CLIENT_ID", cfg.OAuth2.ClientID)
+	cfg.OAuth2.ClientSecret = getEnv("OAUTH2_CLIENT_SECRET", cfg.OAuth2.ClientSecret)
+	cfg.OAuth2.RedirectURL = getEnv("OAUTH2_REDIRECT_URL", cfg.OAuth2.RedirectURL)
+	cfg.OAuth2.Scopes = getEnvAsStringSlice("OAUTH2_SCOPES", cfg.OAuth2.Scopes)
+
+	cfg.Embedding.Provider = getEnv("EMBEDDING_PROVIDER", cfg.Embedding.Provider)
+	cfg.Embedding.ProviderURL = getEnv("EMBEDDING_PROVIDER_URL", cfg.Embedding.ProviderURL)
+	cfg.Embedding.APIKey = getEnv("EMBEDDING_API_KEY", cfg.Embedding.APIKey)
+	cfg.Embedding.Model = getEnv("EMBEDDING_MODEL", cfg.Embedding.Model)
+	cfg.Embedding.Dimension = getEnvAsInt("EMBEDDING_DIMENSION", cfg.Embedding.Dimension)
+
+	cfg.Worker.Broker = getEnv("WORKER_BROKER", cfg.Worker.Broker)
+	cfg.Worker.BrokerURL = getEnv("WORKER_BROKER_URL", cfg.Worker.BrokerURL)
+	cfg.Worker.PoolSize = getEnvAsInt("WORKER_POOL_SIZE", cfg.Worker.PoolSize)
+	cfg.Worker.MaxAttempts = getEnvAsInt("WORKER_MAX_ATTEMPTS", cfg.Worker.MaxAttempts)
+	cfg.Worker.RetryBackoff = getEnvAsInt("WORKER_RETRY_BACKOFF_SECONDS", cfg.Worker.RetryBackoff)
+
+	cfg.Sentry.DSN = getEnv("SENTRY_DSN", cfg.Sentry.DSN)
+	cfg.Sentry.Environment = getEnv("SENTRY_ENVIRONMENT", cfg.Sentry.Environment)
+	cfg.Sentry.TracesSampleRate = getEnvAsFloat("SENTRY_TRACES_SAMPLE_RATE", cfg.Sentry.TracesSampleRate)
+	cfg.Sentry.ProfilesSampleRate = getEnvAsFloat("SENTRY_PROFILES_SAMPLE_RATE", cfg.Sentry.ProfilesSampleRate)
+}
+
+// applySecretFiles resolves the handful of "<VAR>_FILE" references this
+// module supports (e.g. JWT_SECRET_FILE=/run/secrets/jwt), so sensitive
+// values can be mounted from a secrets manager instead of set directly in
+// the environment. A *_FILE variable takes priority over its plain
+// counterpart when both are set.
+func applySecretFiles(cfg *Config) {
+	applySecretFile("JWT_SECRET_FILE", &cfg.JWT.Secret)
+	applySecretFile("DB_PASSWORD_FILE", &cfg.Database.Password)
+	applySecretFile("OAUTH2_CLIENT_SECRET_FILE", &cfg.OAuth2.ClientSecret)
+}
+
+func applySecretFile(envKey string, dst *string) {
+	path := os.Getenv(envKey)
+	if path == "" {
+		return
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Printf("config: reading %s=%s: %v", envKey, path, err)
+		return
+	}
+	*dst = strings.TrimSpace(string(data))
+}
+
 // getEnv gets an environment variable with a default value
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
@@ -63,4 +264,23 @@ func getEnvAsInt(key string, defaultValue int) int {
 		}
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+// getEnvAsStringSlice gets a comma-separated environment variable as a
+// string slice with a default value.
+func getEnvAsStringSlice(key string, defaultValue []string) []string {
+	if value := os.Getenv(key); value != "" {
+		return strings.Split(value, ",")
+	}
+	return defaultValue
+}
+
+// getEnvAsFloat gets an environment variable as a float64 with a default value
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,97 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	valid := defaults()
+	if err := Validate(valid); err != nil {
+		t.Fatalf("Validate(defaults()) returned error: %v", err)
+	}
+
+	invalid := defaults()
+	invalid.Port = ""
+	if err := Validate(invalid); err == nil {
+		t.Fatal("Validate returned nil for a config missing a required field")
+	}
+}
+
+func TestGetEnv(t *testing.T) {
+	t.Setenv("CONFIG_TEST_GETENV", "")
+	if got := getEnv("CONFIG_TEST_GETENV", "fallback"); got != "fallback" {
+		t.Errorf("getEnv with unset var = %q, want %q", got, "fallback")
+	}
+
+	t.Setenv("CONFIG_TEST_GETENV", "set")
+	if got := getEnv("CONFIG_TEST_GETENV", "fallback"); got != "set" {
+		t.Errorf("getEnv with set var = %q, want %q", got, "set")
+	}
+}
+
+func TestGetEnvAsInt(t *testing.T) {
+	t.Setenv("CONFIG_TEST_GETENVASINT", "not-a-number")
+	if got := getEnvAsInt("CONFIG_TEST_GETENVASINT", 42); got != 42 {
+		t.Errorf("getEnvAsInt with unparseable var = %d, want default %d", got, 42)
+	}
+
+	t.Setenv("CONFIG_TEST_GETENVASINT", "7")
+	if got := getEnvAsInt("CONFIG_TEST_GETENVASINT", 42); got != 7 {
+		t.Errorf("getEnvAsInt with set var = %d, want %d", got, 7)
+	}
+}
+
+func TestGetEnvAsStringSlice(t *testing.T) {
+	t.Setenv("CONFIG_TEST_GETENVASSLICE", "")
+	if got := getEnvAsStringSlice("CONFIG_TEST_GETENVASSLICE", []string{"default"}); len(got) != 1 || got[0] != "default" {
+		t.Errorf("getEnvAsStringSlice with unset var = %v, want [default]", got)
+	}
+
+	t.Setenv("CONFIG_TEST_GETENVASSLICE", "a,b,c")
+	got := getEnvAsStringSlice("CONFIG_TEST_GETENVASSLICE", []string{"default"})
+	want := []string{"a", "b", "c"}
+	if len(got) != len(want) {
+		t.Fatalf("getEnvAsStringSlice = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("getEnvAsStringSlice[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+// TestApplySecretFilePrecedence verifies that a "<VAR>_FILE" reference
+// overrides the plain environment variable of the same name, since that
+// precedence is what lets a secrets manager take priority over a
+// plaintext fallback.
+func TestApplySecretFilePrecedence(t *testing.T) {
+	secretPath := filepath.Join(t.TempDir(), "jwt-secret")
+	if err := os.WriteFile(secretPath, []byte("from-file-secret\n"), 0o600); err != nil {
+		t.Fatalf("writing secret file: %v", err)
+	}
+
+	t.Setenv("JWT_SECRET", "from-plain-env")
+	t.Setenv("JWT_SECRET_FILE", secretPath)
+
+	cfg := defaults()
+	cfg.JWT.Secret = "from-plain-env"
+	applySecretFiles(cfg)
+
+	if cfg.JWT.Secret != "from-file-secret" {
+		t.Errorf("JWT.Secret = %q, want secret-file contents to win", cfg.JWT.Secret)
+	}
+}
+
+func TestApplySecretFileMissingPathIsIgnored(t *testing.T) {
+	t.Setenv("JWT_SECRET_FILE", filepath.Join(t.TempDir(), "does-not-exist"))
+
+	cfg := defaults()
+	cfg.JWT.Secret = "unchanged"
+	applySecretFiles(cfg)
+
+	if cfg.JWT.Secret != "unchanged" {
+		t.Errorf("JWT.Secret = %q, want unchanged when secret file can't be read", cfg.JWT.Secret)
+	}
+}
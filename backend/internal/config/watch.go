@@ -0,0 +1,113 @@
+package config
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchLogger is the minimal logging surface Watch needs. *pkg/logger.Logger
+// satisfies it; taking this interface keeps config from importing
+// pkg/logger, a dependency it has no other use for.
+type watchLogger interface {
+	Info(msg string, args ...any)
+	Error(msg string, args ...any)
+}
+
+// Watch loads the initial configuration and returns it behind an
+// AtomicConfig that keeps itself current: a SIGHUP, or a write to the
+// CONFIG_PATH file or any secret file referenced by a *_FILE env var, is
+// treated as a request to reload. A reload that fails validation is logged
+// and discarded, so a bad deploy never takes down an already-running
+// process. Cancel ctx to stop watching.
+func Watch(ctx context.Context, log watchLogger) (*AtomicConfig, error) {
+	cfg := Load()
+	if err := Validate(cfg); err != nil {
+		return nil, err
+	}
+	ac := NewAtomicConfig(cfg)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Error("Failed to start config file watcher, reload on file change is disabled", "error", err)
+		watcher = nil
+	} else {
+		for _, dir := range watchedDirs() {
+			if err := watcher.Add(dir); err != nil {
+				log.Error("Failed to watch config directory", "dir", dir, "error", err)
+			}
+		}
+	}
+
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+
+	go func() {
+		defer signal.Stop(sighup)
+		if watcher != nil {
+			defer watcher.Close()
+		}
+		var events <-chan fsnotify.Event
+		if watcher != nil {
+			events = watcher.Events
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sighup:
+				reloadInto(ac, log, "received SIGHUP")
+			case event, ok := <-events:
+				if !ok {
+					events = nil
+					continue
+				}
+				if event.Op&(fsnotify.Write|fsnotify.Create) != 0 {
+					reloadInto(ac, log, "config file changed: "+event.Name)
+				}
+			}
+		}
+	}()
+
+	return ac, nil
+}
+
+// watchedDirs lists the directories fsnotify should watch: the one holding
+// the CONFIG_PATH file, and the ones holding any secret file referenced by
+// a *_FILE env var. Watching directories rather than files tolerates the
+// atomic-rename-based writes most secret managers and editors use.
+func watchedDirs() []string {
+	var dirs []string
+	seen := map[string]bool{}
+	add := func(path string) {
+		if path == "" {
+			return
+		}
+		dir := filepath.Dir(path)
+		if !seen[dir] {
+			seen[dir] = true
+			dirs = append(dirs, dir)
+		}
+	}
+
+	add(os.Getenv("CONFIG_PATH"))
+	add(os.Getenv("JWT_SECRET_FILE"))
+	add(os.Getenv("DB_PASSWORD_FILE"))
+	add(os.Getenv("OAUTH2_CLIENT_SECRET_FILE"))
+
+	return dirs
+}
+
+func reloadInto(ac *AtomicConfig, log watchLogger, reason string) {
+	next := Load()
+	if err := Validate(next); err != nil {
+		log.Error("Rejected config reload", "reason", reason, "error", err)
+		return
+	}
+	ac.reload(next)
+	log.Info("Configuration reloaded", "reason", reason)
+}
@@ -0,0 +1,50 @@
+// Package database manages the PostgreSQL connection pool and schema migrations.
+package database
+
+import (
+	"database/sql"
+	"fmt"
+
+	"taskservice/internal/config"
+
+	"github.com/XSAM/otelsql"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+
+	_ "github.com/lib/pq"
+)
+
+// NewConnection opens and verifies a PostgreSQL connection pool using the
+// given database configuration. Queries are instrumented with OpenTelemetry
+// spans via otelsql, so they show up as children of the request transaction
+// started by observability.GinMiddleware.
+func NewConnection(cfg config.DatabaseConfig) (*sql.DB, error) {
+	dsn := fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.Name, cfg.SSLMode,
+	)
+
+	db, err := otelsql.Open("postgres", dsn, otelsql.WithAttributes(semconv.DBSystemPostgreSQL))
+	if err != nil {
+		return nil, fmt.Errorf("opening database connection: %w", err)
+	}
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("pinging database: %w", err)
+	}
+
+	return db, nil
+}
+
+// Reconfigure opens a new connection pool for cfg and, once it's verified
+// reachable, swaps it into pool. It returns the pool that was replaced so
+// the caller can close it after giving in-flight queries time to finish.
+// It swaps whenever the new pool connects, even if cfg is unchanged;
+// callers skip the call when nothing changed.
+func Reconfigure(pool *Pool, cfg config.DatabaseConfig) (*sql.DB, error) {
+	next, err := NewConnection(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("reconfiguring database pool: %w", err)
+	}
+	return pool.Swap(next), nil
+}
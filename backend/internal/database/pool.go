@@ -0,0 +1,33 @@
+package database
+
+import (
+	"database/sql"
+	"sync/atomic"
+)
+
+// Pool holds a hot-swappable *sql.DB so a configuration reload (e.g.
+// rotated credentials) can replace the underlying connection pool without
+// restarting the process. The zero value is not usable; construct one with
+// NewPool.
+type Pool struct {
+	current atomic.Pointer[sql.DB]
+}
+
+// NewPool wraps an already-opened connection pool.
+func NewPool(db *sql.DB) *Pool {
+	p := &Pool{}
+	p.current.Store(db)
+	return p
+}
+
+// Current returns the connection pool in effect right now. Safe for
+// concurrent use with Swap.
+func (p *Pool) Current() *sql.DB {
+	return p.current.Load()
+}
+
+// Swap installs db as the current pool and returns the pool it replaced, so
+// the caller can close it once any in-flight queries against it finish.
+func (p *Pool) Swap(db *sql.DB) *sql.DB {
+	return p.current.Swap(db)
+}
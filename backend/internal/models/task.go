@@ -17,6 +17,7 @@ const (
 	TaskStatusCompleted  TaskStatus = "completed"
 	TaskStatusCancelled  TaskStatus = "cancelled"
 	TaskStatusArchived   TaskStatus = "archived"
+	TaskStatusFailed     TaskStatus = "failed"
 )
 
 // TaskPriority represents the priority of a task
@@ -31,56 +32,60 @@ const (
 
 // Task represents a task in the system
 type Task struct {
-	ID             uuid.UUID        `json:"id" db:"id"`
-	Title          string           `json:"title" db:"title" validate:"required,min=3,max=255"`
-	Description    *string          `json:"description,omitempty" db:"description"`
-	Duration       *int             `json:"duration,omitempty" db:"duration" validate:"omitempty,min=1,max=10080"` // in minutes
-	Status         TaskStatus       `json:"status" db:"status"`
-	Priority       TaskPriority     `json:"priority" db:"priority"`
-	Tags           pq.StringArray   `json:"tags" db:"tags"`
-	Metadata       json.RawMessage  `json:"metadata,omitempty" db:"metadata"`
-	EstimatedStart *time.Time       `json:"estimated_start,omitempty" db:"estimated_start"`
-	EstimatedEnd   *time.Time       `json:"estimated_end,omitempty" db:"estimated_end"`
-	ActualStart    *time.Time       `json:"actual_start,omitempty" db:"actual_start"`
-	ActualEnd      *time.Time       `json:"actual_end,omitempty" db:"actual_end"`
-	CreatedAt      time.Time        `json:"created_at" db:"created_at"`
-	UpdatedAt      time.Time        `json:"updated_at" db:"updated_at"`
-	CreatedBy      string           `json:"created_by" db:"created_by"`
+	ID             uuid.UUID       `json:"id" db:"id"`
+	Title          string          `json:"title" db:"title" validate:"required,min=3,max=255"`
+	Description    *string         `json:"description,omitempty" db:"description"`
+	Duration       *int            `json:"duration,omitempty" db:"duration" validate:"omitempty,min=1,max=10080"` // in minutes
+	Status         TaskStatus      `json:"status" db:"status"`
+	Priority       TaskPriority    `json:"priority" db:"priority"`
+	Tags           pq.StringArray  `json:"tags" db:"tags"`
+	Metadata       json.RawMessage `json:"metadata,omitempty" db:"metadata"`
+	EstimatedStart *time.Time      `json:"estimated_start,omitempty" db:"estimated_start"`
+	EstimatedEnd   *time.Time      `json:"estimated_end,omitempty" db:"estimated_end"`
+	ActualStart    *time.Time      `json:"actual_start,omitempty" db:"actual_start"`
+	ActualEnd      *time.Time      `json:"actual_end,omitempty" db:"actual_end"`
+	CreatedAt      time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at" db:"updated_at"`
+	CreatedBy      string          `json:"created_by" db:"created_by"`
 }
 
 // CreateTaskRequest represents the request to create a task
 type CreateTaskRequest struct {
-	Title          string           `json:"title" validate:"required,min=3,max=255"`
-	Description    *string          `json:"description,omitempty"`
-	Duration       *int             `json:"duration,omitempty" validate:"omitempty,min=1,max=10080"`
-	Priority       *TaskPriority    `json:"priority,omitempty"`
-	Tags           []string         `json:"tags,omitempty"`
-	Metadata       json.RawMessage  `json:"metadata,omitempty"`
-	EstimatedStart *time.Time       `json:"estimated_start,omitempty"`
-	EstimatedEnd   *time.Time       `json:"estimated_end,omitempty"`
+	Title          string          `json:"title" validate:"required,min=3,max=255"`
+	Description    *string         `json:"description,omitempty"`
+	Duration       *int            `json:"duration,omitempty" validate:"omitempty,min=1,max=10080"`
+	Priority       *TaskPriority   `json:"priority,omitempty"`
+	Tags           []string        `json:"tags,omitempty"`
+	Metadata       json.RawMessage `json:"metadata,omitempty"`
+	EstimatedStart *time.Time      `json:"estimated_start,omitempty"`
+	EstimatedEnd   *time.Time      `json:"estimated_end,omitempty"`
 }
 
 // UpdateTaskRequest represents the request to update a task
 type UpdateTaskRequest struct {
-	Title          *string          `json:"title,omitempty" validate:"omitempty,min=3,max=255"`
-	Description    *string          `json:"description,omitempty"`
-	Duration       *int             `json:"duration,omitempty" validate:"omitempty,min=1,max=10080"`
-	Status         *TaskStatus      `json:"status,omitempty"`
-	Priority       *TaskPriority    `json:"priority,omitempty"`
-	Tags           *[]string        `json:"tags,omitempty"`
-	Metadata       json.RawMessage  `json:"metadata,omitempty"`
-	EstimatedStart *time.Time       `json:"estimated_start,omitempty"`
-	EstimatedEnd   *time.Time       `json:"estimated_end,omitempty"`
-	ActualStart    *time.Time       `json:"actual_start,omitempty"`
-	ActualEnd      *time.Time       `json:"actual_end,omitempty"`
+	Title          *string         `json:"title,omitempty" validate:"omitempty,min=3,max=255"`
+	Description    *string         `json:"description,omitempty"`
+	Duration       *int            `json:"duration,omitempty" validate:"omitempty,min=1,max=10080"`
+	Status         *TaskStatus     `json:"status,omitempty"`
+	Priority       *TaskPriority   `json:"priority,omitempty"`
+	Tags           *[]string       `json:"tags,omitempty"`
+	Metadata       json.RawMessage `json:"metadata,omitempty"`
+	EstimatedStart *time.Time      `json:"estimated_start,omitempty"`
+	EstimatedEnd   *time.Time      `json:"estimated_end,omitempty"`
+	ActualStart    *time.Time      `json:"actual_start,omitempty"`
+	ActualEnd      *time.Time      `json:"actual_end,omitempty"`
 }
 
-// TasksResponse represents the response for listing tasks
+// TasksResponse represents the response for listing tasks. Total and Page
+// are only populated for offset pagination; NextCursor is set whenever
+// another page is available and lets the caller switch to (or continue)
+// keyset pagination instead.
 type TasksResponse struct {
-	Tasks []Task `json:"tasks"`
-	Total int    `json:"total"`
-	Page  int    `json:"page"`
-	Limit int    `json:"limit"`
+	Tasks      []Task `json:"tasks"`
+	Total      int    `json:"total,omitempty"`
+	Page       int    `json:"page,omitempty"`
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // ErrorResponse represents an error response
@@ -92,8 +97,29 @@ type ErrorResponse struct {
 
 // SearchTasksResponse represents the response for task search
 type SearchTasksResponse struct {
-	TaskID     uuid.UUID `json:"task_id" db:"task_id"`
-	Title      string    `json:"title" db:"title"`
-	Description *string  `json:"description,omitempty" db:"description"`
-	Similarity float32   `json:"similarity_score" db:"similarity_score"`
-}
\ No newline at end of file
+	TaskID      uuid.UUID `json:"task_id" db:"task_id"`
+	Title       string    `json:"title" db:"title"`
+	Description *string   `json:"description,omitempty" db:"description"`
+	Similarity  float32   `json:"similarity_score" db:"similarity_score"`
+}
+
+// TaskRun represents a single execution attempt of a task by the worker.
+type TaskRun struct {
+	ID        uuid.UUID  `json:"id" db:"id"`
+	TaskID    uuid.UUID  `json:"task_id" db:"task_id"`
+	Attempt   int        `json:"attempt" db:"attempt"`
+	Status    TaskStatus `json:"status" db:"status"`
+	Logs      string     `json:"logs" db:"logs"`
+	Error     *string    `json:"error,omitempty" db:"error"`
+	StartedAt *time.Time `json:"started_at,omitempty" db:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty" db:"ended_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+// RunTaskResponse represents the response for enqueuing a task run.
+type RunTaskResponse struct {
+	RunID   uuid.UUID  `json:"run_id"`
+	TaskID  uuid.UUID  `json:"task_id"`
+	Status  TaskStatus `json:"status"`
+	Attempt int        `json:"attempt"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// User represents an account authenticated via the OAuth2/OIDC login flow.
+type User struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	Email       string    `json:"email" db:"email"`
+	Name        string    `json:"name" db:"name"`
+	Provider    string    `json:"provider" db:"provider"`
+	ProviderSub string    `json:"-" db:"provider_sub"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// LoginResponse represents the response returned after a completed
+// OAuth2/OIDC login, carrying the module's locally-issued JWT.
+type LoginResponse struct {
+	Token string `json:"token"`
+	User  User   `json:"user"`
+}
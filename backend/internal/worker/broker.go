@@ -0,0 +1,52 @@
+// Package worker implements asynchronous task execution on top of a
+// pluggable message broker.
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"taskservice/internal/config"
+)
+
+// Job represents one execution attempt of a task, enqueued onto the broker
+// by the API and consumed by the worker binary.
+type Job struct {
+	TaskID  string `json:"task_id"`
+	RunID   string `json:"run_id"`
+	Attempt int    `json:"attempt"`
+}
+
+// Broker enqueues and consumes task run jobs on a message backend.
+type Broker interface {
+	// Enqueue publishes a job for later consumption.
+	Enqueue(ctx context.Context, job Job) error
+
+	// Consume blocks, invoking handler for every job received, until ctx is
+	// cancelled or an unrecoverable error occurs.
+	Consume(ctx context.Context, handler func(context.Context, Job) error) error
+
+	// Close releases the broker's underlying connection.
+	Close() error
+}
+
+// NewBroker builds a Broker from the worker configuration. On error the
+// returned Broker is nil.
+func NewBroker(cfg config.WorkerConfig) (Broker, error) {
+	switch cfg.Broker {
+	case "nats":
+		b, err := NewNATSBroker(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return b, nil
+	case "redis":
+		b, err := NewRedisBroker(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("worker: unknown broker %q", cfg.Broker)
+	}
+}
@@ -0,0 +1,25 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"taskservice/internal/models"
+)
+
+// Executor performs the actual work of a task run. Consumers of this
+// package supply a domain-specific implementation; NoopExecutor is used
+// where no execution logic has been wired up yet.
+type Executor interface {
+	Execute(ctx context.Context, task models.Task, logw io.Writer) error
+}
+
+// NoopExecutor records that a task ran without performing any real work.
+type NoopExecutor struct{}
+
+// Execute implements Executor.
+func (NoopExecutor) Execute(_ context.Context, task models.Task, logw io.Writer) error {
+	fmt.Fprintf(logw, "no executor configured for task %s, marking complete\n", task.ID)
+	return nil
+}
@@ -0,0 +1,123 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"taskservice/internal/config"
+
+	"github.com/nats-io/nats.go"
+)
+
+const taskRunsSubject = "tasks.runs"
+const taskRunsStream = "TASK_RUNS"
+const taskRunsConsumer = "task-runner"
+
+// NATSBroker implements Broker on top of NATS JetStream.
+type NATSBroker struct {
+	conn      *nats.Conn
+	js        nats.JetStreamContext
+	batchSize int
+}
+
+// NewNATSBroker connects to NATS and ensures the task-runs stream exists.
+func NewNATSBroker(cfg config.WorkerConfig) (*NATSBroker, error) {
+	conn, err := nats.Connect(cfg.BrokerURL)
+	if err != nil {
+		return nil, fmt.Errorf("worker: connecting to nats: %w", err)
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("worker: initializing jetstream: %w", err)
+	}
+
+	_, err = js.AddStream(&nats.StreamConfig{
+		Name:     taskRunsStream,
+		Subjects: []string{taskRunsSubject},
+	})
+	if err != nil && err != nats.ErrStreamNameAlreadyInUse {
+		conn.Close()
+		return nil, fmt.Errorf("worker: creating stream: %w", err)
+	}
+
+	batchSize := cfg.PoolSize
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	return &NATSBroker{conn: conn, js: js, batchSize: batchSize}, nil
+}
+
+// Enqueue implements Broker.
+func (b *NATSBroker) Enqueue(ctx context.Context, job Job) error {
+	payload, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("worker: encoding job: %w", err)
+	}
+
+	_, err = b.js.Publish(taskRunsSubject, payload, nats.Context(ctx))
+	if err != nil {
+		return fmt.Errorf("worker: publishing job: %w", err)
+	}
+	return nil
+}
+
+// Consume implements Broker. Messages are fetched in batches of up to
+// batchSize and handed to handler concurrently, one goroutine per message;
+// the caller's handler (Runner.handleJob) is what actually bounds
+// concurrency via its semaphore, so prefetching a batch here just keeps
+// the pool saturated instead of serializing on a single in-flight job.
+func (b *NATSBroker) Consume(ctx context.Context, handler func(context.Context, Job) error) error {
+	sub, err := b.js.PullSubscribe(taskRunsSubject, taskRunsConsumer)
+	if err != nil {
+		return fmt.Errorf("worker: subscribing: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		msgs, err := sub.Fetch(b.batchSize, nats.MaxWait(time.Second))
+		if err != nil {
+			if err == nats.ErrTimeout {
+				continue
+			}
+			return fmt.Errorf("worker: fetching messages: %w", err)
+		}
+
+		for _, msg := range msgs {
+			var job Job
+			if err := json.Unmarshal(msg.Data, &job); err != nil {
+				msg.Term()
+				continue
+			}
+
+			wg.Add(1)
+			go func(msg *nats.Msg, job Job) {
+				defer wg.Done()
+				if err := handler(ctx, job); err != nil {
+					msg.Nak()
+					return
+				}
+				msg.Ack()
+			}(msg, job)
+		}
+	}
+}
+
+// Close implements Broker.
+func (b *NATSBroker) Close() error {
+	b.conn.Close()
+	return nil
+}
@@ -0,0 +1,123 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"taskservice/internal/config"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const taskRunsStreamKey = "tasks:runs"
+const taskRunsGroup = "task-runner"
+
+// RedisBroker implements Broker on top of a Redis Stream consumer group.
+type RedisBroker struct {
+	client    *redis.Client
+	batchSize int64
+}
+
+// NewRedisBroker connects to Redis and ensures the task-runs consumer group exists.
+func NewRedisBroker(cfg config.WorkerConfig) (*RedisBroker, error) {
+	opts, err := redis.ParseURL(cfg.BrokerURL)
+	if err != nil {
+		return nil, fmt.Errorf("worker: parsing redis url: %w", err)
+	}
+	client := redis.NewClient(opts)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err = client.XGroupCreateMkStream(ctx, taskRunsStreamKey, taskRunsGroup, "0").Err()
+	if err != nil && !errors.Is(err, redis.Nil) && !isBusyGroupErr(err) {
+		client.Close()
+		return nil, fmt.Errorf("worker: creating consumer group: %w", err)
+	}
+
+	batchSize := int64(cfg.PoolSize)
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	return &RedisBroker{client: client, batchSize: batchSize}, nil
+}
+
+func isBusyGroupErr(err error) bool {
+	return err != nil && err.Error() == "BUSYGROUP Consumer Group name already exists"
+}
+
+// Enqueue implements Broker.
+func (b *RedisBroker) Enqueue(ctx context.Context, job Job) error {
+	payload, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("worker: encoding job: %w", err)
+	}
+
+	return b.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: taskRunsStreamKey,
+		Values: map[string]interface{}{"payload": payload},
+	}).Err()
+}
+
+// Consume implements Broker. Messages are read in batches of up to
+// batchSize and handed to handler concurrently, one goroutine per message;
+// the caller's handler (Runner.handleJob) is what actually bounds
+// concurrency via its semaphore, so reading a batch here just keeps the
+// pool saturated instead of serializing on a single in-flight job.
+func (b *RedisBroker) Consume(ctx context.Context, handler func(context.Context, Job) error) error {
+	consumerName := fmt.Sprintf("worker-%d", time.Now().UnixNano())
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		streams, err := b.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    taskRunsGroup,
+			Consumer: consumerName,
+			Streams:  []string{taskRunsStreamKey, ">"},
+			Count:    b.batchSize,
+			Block:    time.Second,
+		}).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) || errors.Is(err, context.DeadlineExceeded) {
+				continue
+			}
+			return fmt.Errorf("worker: reading stream: %w", err)
+		}
+
+		for _, stream := range streams {
+			for _, msg := range stream.Messages {
+				var job Job
+				payload, _ := msg.Values["payload"].(string)
+				if err := json.Unmarshal([]byte(payload), &job); err != nil {
+					b.client.XAck(ctx, taskRunsStreamKey, taskRunsGroup, msg.ID)
+					continue
+				}
+
+				wg.Add(1)
+				go func(msgID string, job Job) {
+					defer wg.Done()
+					if err := handler(ctx, job); err != nil {
+						return
+					}
+					b.client.XAck(ctx, taskRunsStreamKey, taskRunsGroup, msgID)
+				}(msg.ID, job)
+			}
+		}
+	}
+}
+
+// Close implements Broker.
+func (b *RedisBroker) Close() error {
+	return b.client.Close()
+}
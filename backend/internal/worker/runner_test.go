@@ -0,0 +1,53 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestExhaustedRetries(t *testing.T) {
+	cases := []struct {
+		attempt, maxAttempts int
+		want                 bool
+	}{
+		{attempt: 1, maxAttempts: 3, want: false},
+		{attempt: 2, maxAttempts: 3, want: false},
+		{attempt: 3, maxAttempts: 3, want: true},
+		{attempt: 4, maxAttempts: 3, want: true},
+	}
+	for _, c := range cases {
+		if got := exhaustedRetries(c.attempt, c.maxAttempts); got != c.want {
+			t.Errorf("exhaustedRetries(%d, %d) = %v, want %v", c.attempt, c.maxAttempts, got, c.want)
+		}
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	cases := []struct {
+		baseSeconds, attempt int
+		want                 time.Duration
+	}{
+		{baseSeconds: 5, attempt: 1, want: 5 * time.Second},
+		{baseSeconds: 5, attempt: 2, want: 10 * time.Second},
+		{baseSeconds: 5, attempt: 3, want: 20 * time.Second},
+	}
+	for _, c := range cases {
+		if got := retryBackoff(c.baseSeconds, c.attempt); got != c.want {
+			t.Errorf("retryBackoff(%d, %d) = %v, want %v", c.baseSeconds, c.attempt, got, c.want)
+		}
+	}
+}
+
+func TestWaitBackoffRespectsContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	if err := waitBackoff(ctx, time.Minute); err == nil {
+		t.Fatal("expected waitBackoff to return an error for a cancelled context")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("waitBackoff blocked for %v, want near-immediate return on cancellation", elapsed)
+	}
+}
@@ -1,13 +1,16 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
+	"time"
 
 	"taskservice/internal/api"
 	"taskservice/internal/config"
 	"taskservice/internal/database"
 	"taskservice/pkg/logger"
+	"taskservice/pkg/observability"
 
 	"github.com/joho/godotenv"
 )
@@ -37,17 +40,34 @@ func main() {
 	// Initialize logger
 	logger := logger.New()
 
-	// Load configuration
-	cfg := config.Load()
+	// Load configuration, keeping it live so a SIGHUP or a change to
+	// CONFIG_PATH/a *_FILE secret reference can rotate the JWT secret,
+	// reopen the database pool, or adjust the log level without a restart.
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	defer stopWatch()
+
+	atomicCfg, err := config.Watch(watchCtx, logger)
+	if err != nil {
+		logger.Error("Failed to load configuration", "error", err)
+		os.Exit(1)
+	}
+	cfg := atomicCfg.Get()
 	logger.Info("Configuration loaded", "port", cfg.Port, "database", cfg.Database.Host)
 
+	// Initialize Sentry + OpenTelemetry tracing
+	if err := observability.Init(cfg.Sentry); err != nil {
+		logger.Error("Failed to initialize observability, continuing without it", "error", err)
+	}
+	defer observability.Flush(2 * time.Second)
+
 	// Initialize database
-	db, err := database.NewConnection(cfg.Database)
+	conn, err := database.NewConnection(cfg.Database)
 	if err != nil {
 		logger.Error("Failed to connect to database", "error", err)
 		os.Exit(1)
 	}
-	defer db.Close()
+	db := database.NewPool(conn)
+	defer func() { db.Current().Close() }()
 
 	// Run migrations
 	if err := database.RunMigrations(cfg.Database); err != nil {
@@ -55,8 +75,26 @@ func main() {
 		os.Exit(1)
 	}
 
+	atomicCfg.Subscribe(func(next *config.Config) {
+		if err := logger.SetLevel(next.LogLevel); err != nil {
+			logger.Error("Failed to apply reloaded log level", "error", err)
+		}
+	})
+	lastDatabaseCfg := cfg.Database
+	atomicCfg.Subscribe(func(next *config.Config) {
+		if next.Database == lastDatabaseCfg {
+			return
+		}
+		lastDatabaseCfg = next.Database
+		if old, err := database.Reconfigure(db, next.Database); err != nil {
+			logger.Error("Failed to apply reloaded database configuration, keeping existing pool", "error", err)
+		} else if old != nil {
+			time.AfterFunc(30*time.Second, func() { old.Close() })
+		}
+	})
+
 	// Initialize API server
-	server := api.NewServer(db, logger, cfg)
+	server := api.NewServer(db, logger, atomicCfg)
 
 	// Start server
 	logger.Info("Starting server", "port", cfg.Port)
@@ -64,4 +102,4 @@ func main() {
 		logger.Error("Server failed to start", "error", err)
 		os.Exit(1)
 	}
-}
\ No newline at end of file
+}
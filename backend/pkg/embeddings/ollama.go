@@ -0,0 +1,78 @@
+package embeddings
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"taskservice/internal/config"
+)
+
+// OllamaProvider generates embeddings via a local Ollama /api/embeddings endpoint.
+type OllamaProvider struct {
+	url       string
+	model     string
+	dimension int
+	client    *http.Client
+}
+
+// NewOllamaProvider creates an OllamaProvider from the embedding configuration.
+func NewOllamaProvider(cfg config.EmbeddingConfig) *OllamaProvider {
+	return &OllamaProvider{
+		url:       cfg.ProviderURL,
+		model:     cfg.Model,
+		dimension: cfg.Dimension,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type ollamaEmbeddingRequest struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+}
+
+type ollamaEmbeddingResponse struct {
+	Embedding []float32 `json:"embedding"`
+}
+
+// Embed implements Provider.
+func (p *OllamaProvider) Embed(ctx context.Context, text string) ([]float32, error) {
+	body, err := json.Marshal(ollamaEmbeddingRequest{Model: p.model, Prompt: text})
+	if err != nil {
+		return nil, fmt.Errorf("embeddings: encoding request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("embeddings: building request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProviderUnreachable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, fmt.Errorf("%w: status %d", ErrProviderUnreachable, resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("embeddings: provider returned status %d", resp.StatusCode)
+	}
+
+	var parsed ollamaEmbeddingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("embeddings: decoding response: %w", err)
+	}
+
+	return parsed.Embedding, nil
+}
+
+// Dimension implements Provider.
+func (p *OllamaProvider) Dimension() int {
+	return p.dimension
+}
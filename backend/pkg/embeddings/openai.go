@@ -0,0 +1,87 @@
+package embeddings
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"taskservice/internal/config"
+)
+
+// OpenAIProvider generates embeddings via the OpenAI-compatible
+// /embeddings HTTP API.
+type OpenAIProvider struct {
+	url       string
+	apiKey    string
+	model     string
+	dimension int
+	client    *http.Client
+}
+
+// NewOpenAIProvider creates an OpenAIProvider from the embedding configuration.
+func NewOpenAIProvider(cfg config.EmbeddingConfig) *OpenAIProvider {
+	return &OpenAIProvider{
+		url:       cfg.ProviderURL,
+		apiKey:    cfg.APIKey,
+		model:     cfg.Model,
+		dimension: cfg.Dimension,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type openAIEmbeddingRequest struct {
+	Model string `json:"model"`
+	Input string `json:"input"`
+}
+
+type openAIEmbeddingResponse struct {
+	Data []struct {
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+// Embed implements Provider.
+func (p *OpenAIProvider) Embed(ctx context.Context, text string) ([]float32, error) {
+	body, err := json.Marshal(openAIEmbeddingRequest{Model: p.model, Input: text})
+	if err != nil {
+		return nil, fmt.Errorf("embeddings: encoding request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("embeddings: building request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+p.apiKey)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProviderUnreachable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return nil, fmt.Errorf("%w: status %d", ErrProviderUnreachable, resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("embeddings: provider returned status %d", resp.StatusCode)
+	}
+
+	var parsed openAIEmbeddingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("embeddings: decoding response: %w", err)
+	}
+	if len(parsed.Data) == 0 {
+		return nil, fmt.Errorf("embeddings: empty response from provider")
+	}
+
+	return parsed.Data[0].Embedding, nil
+}
+
+// Dimension implements Provider.
+func (p *OpenAIProvider) Dimension() int {
+	return p.dimension
+}
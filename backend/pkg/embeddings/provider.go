@@ -0,0 +1,50 @@
+// Package embeddings provides vector embedding generation for semantic task search.
+package embeddings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"taskservice/internal/config"
+)
+
+// ErrProviderUnreachable is returned when the embedding provider cannot be
+// reached, allowing callers to degrade to a non-semantic search path.
+var ErrProviderUnreachable = errors.New("embeddings: provider unreachable")
+
+// StoredDimension is the width of the tasks.embedding pgvector column, set
+// by migration 000002_add_task_embeddings. pgvector columns have a fixed
+// width, so EMBEDDING_DIMENSION can only select a model whose output
+// matches it; widening it requires a new migration to ALTER COLUMN TYPE
+// and rebuild the ivfflat index.
+const StoredDimension = 1536
+
+// Provider generates a vector embedding for a piece of text.
+type Provider interface {
+	// Embed returns the embedding vector for text. It returns
+	// ErrProviderUnreachable (wrapped) if the provider could not be reached.
+	Embed(ctx context.Context, text string) ([]float32, error)
+
+	// Dimension reports the length of vectors produced by this provider.
+	Dimension() int
+}
+
+// NewProvider builds a Provider from the application's embedding
+// configuration. It rejects a Dimension that doesn't match StoredDimension
+// up front, since that would otherwise surface later as a pgvector
+// dimension-mismatch error on every insert.
+func NewProvider(cfg config.EmbeddingConfig) (Provider, error) {
+	if cfg.Dimension != StoredDimension {
+		return nil, fmt.Errorf("embeddings: dimension %d does not match the tasks.embedding column width %d; choose a model with matching output size or migrate the column", cfg.Dimension, StoredDimension)
+	}
+
+	switch cfg.Provider {
+	case "openai":
+		return NewOpenAIProvider(cfg), nil
+	case "ollama":
+		return NewOllamaProvider(cfg), nil
+	default:
+		return nil, fmt.Errorf("embeddings: unknown provider %q", cfg.Provider)
+	}
+}
@@ -0,0 +1,28 @@
+package embeddings
+
+import (
+	"testing"
+
+	"taskservice/internal/config"
+)
+
+func TestNewProviderRejectsMismatchedDimension(t *testing.T) {
+	cfg := config.EmbeddingConfig{Provider: "openai", Dimension: StoredDimension + 1}
+	if _, err := NewProvider(cfg); err == nil {
+		t.Fatal("expected an error for a dimension that doesn't match StoredDimension")
+	}
+}
+
+func TestNewProviderAcceptsMatchingDimension(t *testing.T) {
+	cfg := config.EmbeddingConfig{Provider: "openai", Dimension: StoredDimension}
+	if _, err := NewProvider(cfg); err != nil {
+		t.Fatalf("NewProvider returned unexpected error: %v", err)
+	}
+}
+
+func TestNewProviderRejectsUnknownProvider(t *testing.T) {
+	cfg := config.EmbeddingConfig{Provider: "unknown", Dimension: StoredDimension}
+	if _, err := NewProvider(cfg); err == nil {
+		t.Fatal("expected an error for an unknown provider")
+	}
+}
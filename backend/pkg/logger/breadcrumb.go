@@ -0,0 +1,55 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// sentryBreadcrumbHandler wraps a slog.Handler so every log record is also
+// recorded as a Sentry breadcrumb. It is a no-op until the process has
+// called sentry.Init, so Logger.New can always install it unconditionally.
+type sentryBreadcrumbHandler struct {
+	slog.Handler
+}
+
+func (h *sentryBreadcrumbHandler) Handle(ctx context.Context, r slog.Record) error {
+	data := make(map[string]interface{}, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		data[a.Key] = a.Value.Any()
+		return true
+	})
+
+	hub := sentry.GetHubFromContext(ctx)
+	if hub == nil {
+		hub = sentry.CurrentHub()
+	}
+	hub.AddBreadcrumb(&sentry.Breadcrumb{
+		Category: "log",
+		Message:  r.Message,
+		Level:    sentryLevel(r.Level),
+		Data:     data,
+	}, nil)
+
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *sentryBreadcrumbHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &sentryBreadcrumbHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *sentryBreadcrumbHandler) WithGroup(name string) slog.Handler {
+	return &sentryBreadcrumbHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+func sentryLevel(level slog.Level) sentry.Level {
+	switch {
+	case level >= slog.LevelError:
+		return sentry.LevelError
+	case level >= slog.LevelWarn:
+		return sentry.LevelWarning
+	default:
+		return sentry.LevelInfo
+	}
+}
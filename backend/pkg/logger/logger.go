@@ -0,0 +1,48 @@
+// Package logger provides a thin structured-logging wrapper used across the service.
+package logger
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+)
+
+// Logger wraps slog.Logger so call sites don't depend on the underlying
+// logging library directly.
+type Logger struct {
+	*slog.Logger
+	level *slog.LevelVar
+}
+
+// New creates a Logger that writes structured JSON to stdout at info level.
+// Every record is also recorded as a Sentry breadcrumb, so the log trail
+// leading up to an error is attached to whatever event reports it. Call
+// sites on a request path should use the *Context methods (e.g.
+// ErrorContext) with the request's context.Context, so the breadcrumb
+// lands on that request's Sentry hub rather than the global one; the
+// context-less methods still work but fall back to the global hub. Use
+// SetLevel to change the level afterwards, e.g. from a config reload.
+func New() *Logger {
+	level := &slog.LevelVar{}
+	level.Set(slog.LevelInfo)
+
+	handler := &sentryBreadcrumbHandler{
+		Handler: slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+			Level: level,
+		}),
+	}
+	return &Logger{Logger: slog.New(handler), level: level}
+}
+
+// SetLevel changes the minimum level this Logger emits at, taking effect
+// immediately for all outstanding references to it. It accepts "debug",
+// "info", "warn" and "error" (case-insensitive); an unrecognized value is
+// rejected and the current level is left unchanged.
+func (l *Logger) SetLevel(level string) error {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return fmt.Errorf("logger: unknown level %q: %w", level, err)
+	}
+	l.level.Set(lvl)
+	return nil
+}
@@ -0,0 +1,70 @@
+package observability
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"taskservice/internal/models"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/getsentry/sentry-go"
+	sentrygin "github.com/getsentry/sentry-go/gin"
+)
+
+// GinMiddleware starts a Sentry transaction per request (via the official
+// sentry-gin integration) and reports 5xx responses as Sentry events with
+// the ErrorResponse body attached as context. The task_id/user_id tags are
+// set on the request's scope after the handler chain has returned, so they
+// reach that 5xx event; the transaction has already finished by then and
+// does not carry them.
+func GinMiddleware() gin.HandlerFunc {
+	sentryHandler := sentrygin.New(sentrygin.Options{Repanic: true})
+
+	return func(c *gin.Context) {
+		bw := &bodyWriter{ResponseWriter: c.Writer, body: &bytes.Buffer{}}
+		c.Writer = bw
+
+		sentryHandler(c)
+
+		hub := sentrygin.GetHubFromContext(c)
+		if hub == nil {
+			hub = sentry.CurrentHub()
+		}
+		if taskID := c.Param("id"); taskID != "" {
+			hub.Scope().SetTag("task_id", taskID)
+		}
+		if userID := c.GetString("user_id"); userID != "" {
+			hub.Scope().SetTag("user_id", userID)
+		}
+
+		status := c.Writer.Status()
+		if status < http.StatusInternalServerError {
+			return
+		}
+
+		var errResp models.ErrorResponse
+		if err := json.Unmarshal(bw.body.Bytes(), &errResp); err == nil {
+			hub.Scope().SetContext("error_response", map[string]interface{}{
+				"error":   errResp.Error,
+				"message": errResp.Message,
+				"details": errResp.Details,
+			})
+		}
+		hub.CaptureMessage(fmt.Sprintf("%d response for %s %s", status, c.Request.Method, c.FullPath()))
+	}
+}
+
+// bodyWriter captures the response body alongside writing it through, so
+// the Sentry event for a 5xx response can attach it as context.
+type bodyWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *bodyWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
@@ -0,0 +1,61 @@
+// Package observability wires Sentry error tracking and OpenTelemetry
+// distributed tracing across the HTTP server, database layer and workers.
+package observability
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"taskservice/internal/config"
+
+	"github.com/getsentry/sentry-go"
+	sentryotel "github.com/getsentry/sentry-go/otel"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// healthCheckPrefix matches the transaction name Sentry assigns to the
+// health-check route, which the sampler below drops to cut noise.
+const healthCheckPrefix = "GET /health"
+
+// Init configures the global Sentry SDK and bridges it into an
+// OpenTelemetry tracer provider via sentryotel, so spans recorded by the
+// Gin middleware, the database layer and the worker all flow into the same
+// Sentry transaction and sentry-trace/baggage headers propagate across
+// service boundaries. It is a no-op if cfg.DSN is empty.
+func Init(cfg config.SentryConfig) error {
+	if cfg.DSN == "" {
+		return nil
+	}
+
+	err := sentry.Init(sentry.ClientOptions{
+		Dsn:                cfg.DSN,
+		Environment:        cfg.Environment,
+		EnableTracing:      true,
+		ProfilesSampleRate: cfg.ProfilesSampleRate,
+		TracesSampler: sentry.TracesSampler(func(ctx sentry.SamplingContext) float64 {
+			if ctx.Span != nil && strings.HasPrefix(ctx.Span.Name, healthCheckPrefix) {
+				return 0
+			}
+			return cfg.TracesSampleRate
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("observability: initializing sentry: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(sentryotel.NewSentrySpanProcessor()),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(sentryotel.NewSentryPropagator())
+
+	return nil
+}
+
+// Flush blocks until buffered Sentry events are sent or timeout elapses.
+// Call it just before process exit so in-flight events aren't dropped.
+func Flush(timeout time.Duration) {
+	sentry.Flush(timeout)
+}